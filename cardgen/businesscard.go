@@ -0,0 +1,35 @@
+package cardgen
+
+import "strings"
+
+// BusinessCard holds the data for a card. It's a superset of what either the
+// CSV batch flow or the HTTP signature flow needs; a flow that doesn't have a
+// given field (e.g. the CSV flow has no Department) just leaves it zero.
+type BusinessCard struct {
+	Name        string
+	Pronouns    string
+	Title       string
+	Company     string
+	Department  string
+	Address     string
+	LandGrant   []string
+	PhoneNumber string
+	Email       string
+}
+
+// formatPhoneNumber normalizes a free-form phone number into
+// "(555) 555-1234" when it contains exactly 10 digits, leaving anything else
+// unchanged.
+func formatPhoneNumber(raw string) string {
+	digitsOnly := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, raw)
+
+	if len(digitsOnly) != 10 {
+		return raw
+	}
+	return "(" + digitsOnly[0:3] + ") " + digitsOnly[3:6] + "-" + digitsOnly[6:10]
+}
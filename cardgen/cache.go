@@ -0,0 +1,163 @@
+package cardgen
+
+import (
+	"fmt"
+	"image"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+)
+
+// FontCache parses each font file at most once and reuses the resulting
+// *opentype.Font, which is read-only and safe to share, across however many
+// cards get rendered against it. Without it, a large batch run re-reads and
+// re-parses the same TTF off disk on every row.
+//
+// Face and MultiFace deliberately do NOT cache the font.Face values they
+// build: a font.Face carries a mutable rasterization buffer that the
+// golang.org/x/image/font docs call out as unsafe for concurrent use, and
+// batch.go's worker pool calls these concurrently from goroutine to
+// goroutine. Each call builds a fresh Face over the cached Font instead, so
+// every caller gets its own buffer.
+type FontCache struct {
+	mu    sync.Mutex
+	fonts map[string]*opentype.Font
+}
+
+// NewFontCache returns an empty FontCache ready to use.
+func NewFontCache() *FontCache {
+	return &FontCache{
+		fonts: make(map[string]*opentype.Font),
+	}
+}
+
+// Font returns the parsed font at path, parsing and caching it on first use.
+func (c *FontCache) Font(path string) (*opentype.Font, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fontLocked(path)
+}
+
+// fontLocked is Font's body, callable while c.mu is already held.
+func (c *FontCache) fontLocked(path string) (*opentype.Font, error) {
+	if f, ok := c.fonts[path]; ok {
+		return f, nil
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read font file: %w", err)
+	}
+	parsed, err := opentype.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse font: %w", err)
+	}
+
+	c.fonts[path] = parsed
+	return parsed, nil
+}
+
+// Face returns a new font.Face for the font at path at the given point
+// size. The underlying *opentype.Font is parsed at most once and reused,
+// but the returned Face itself is never shared: building it is cheap
+// compared to parsing the font file, and each caller needs its own
+// rasterization buffer anyway.
+func (c *FontCache) Face(path string, size float64) (font.Face, error) {
+	c.mu.Lock()
+	f, err := c.fontLocked(path)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create font face at size %.1f: %w", size, err)
+	}
+	return face, nil
+}
+
+// MultiFace returns a font.Face for primaryPath at size that falls back, per
+// rune, to each of fallbackPaths in order for any glyph primaryPath doesn't
+// have. With no fallbackPaths it's equivalent to Face. Like Face, the
+// returned Face is freshly built every call and not shared across callers.
+func (c *FontCache) MultiFace(primaryPath string, size float64, fallbackPaths []string) (font.Face, error) {
+	if len(fallbackPaths) == 0 {
+		return c.Face(primaryPath, size)
+	}
+
+	primary, err := c.Face(primaryPath, size)
+	if err != nil {
+		return nil, err
+	}
+	faces := []font.Face{primary}
+	for _, path := range fallbackPaths {
+		f, err := c.Face(path, size)
+		if err != nil {
+			return nil, fmt.Errorf("fallback font %s: %w", path, err)
+		}
+		faces = append(faces, f)
+	}
+
+	return NewMultiFace(faces...)
+}
+
+// BackgroundCache decodes each background image at most once and reuses the
+// result, so a batch run doesn't re-decode the same PNG on every row.
+type BackgroundCache struct {
+	mu     sync.Mutex
+	images map[string]image.Image
+}
+
+// NewBackgroundCache returns an empty BackgroundCache ready to use.
+func NewBackgroundCache() *BackgroundCache {
+	return &BackgroundCache{images: make(map[string]image.Image)}
+}
+
+// Get returns the decoded background image at path, decoding and caching it
+// on first use.
+func (c *BackgroundCache) Get(path string) (image.Image, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if img, ok := c.images[path]; ok {
+		return img, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open background image: %w", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode background image: %w", err)
+	}
+
+	c.images[path] = img
+	return img, nil
+}
+
+// RenderCache bundles the caches GenerateCardWithCache draws on. Share one
+// across an entire batch run so repeated fonts and backgrounds are only read
+// and parsed once.
+type RenderCache struct {
+	Fonts       *FontCache
+	Backgrounds *BackgroundCache
+}
+
+// NewRenderCache returns an empty RenderCache ready to use.
+func NewRenderCache() *RenderCache {
+	return &RenderCache{
+		Fonts:       NewFontCache(),
+		Backgrounds: NewBackgroundCache(),
+	}
+}
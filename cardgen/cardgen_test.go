@@ -0,0 +1,131 @@
+package cardgen
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// benchAssets writes a real TTF pair (the stdlib's embedded Go fonts, so no
+// network or repo fixtures are needed) and a background PNG to a temp
+// directory, returning their paths.
+func benchAssets(tb testing.TB) (bgPath, regularPath, boldPath string) {
+	tb.Helper()
+	dir := tb.TempDir()
+
+	regularPath = filepath.Join(dir, "regular.ttf")
+	if err := os.WriteFile(regularPath, goregular.TTF, 0644); err != nil {
+		tb.Fatalf("failed to write regular font: %v", err)
+	}
+	boldPath = filepath.Join(dir, "bold.ttf")
+	if err := os.WriteFile(boldPath, gobold.TTF, 0644); err != nil {
+		tb.Fatalf("failed to write bold font: %v", err)
+	}
+
+	bg := image.NewRGBA(image.Rect(0, 0, 1050, 600))
+	for y := bg.Rect.Min.Y; y < bg.Rect.Max.Y; y++ {
+		for x := bg.Rect.Min.X; x < bg.Rect.Max.X; x++ {
+			bg.Set(x, y, color.White)
+		}
+	}
+	bgPath = filepath.Join(dir, "bg.png")
+	f, err := os.Create(bgPath)
+	if err != nil {
+		tb.Fatalf("failed to create background: %v", err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, bg); err != nil {
+		tb.Fatalf("failed to encode background: %v", err)
+	}
+
+	return bgPath, regularPath, boldPath
+}
+
+func benchTemplate() *Template {
+	return &Template{
+		Name: "bench",
+		Boxes: []TemplateBox{
+			{Name: "name", Text: "{{.Name}}", X: 20, Y: 20, Width: 400, Font: "bold", Size: 24},
+			{Name: "title", Text: "{{.Title}}", X: 20, Y: 60, Width: 400, Font: "regular", Size: 16},
+			{Name: "email", Text: "{{.Email}}", X: 20, Y: 90, Width: 400, Font: "regular", Size: 14},
+		},
+	}
+}
+
+func benchCard() BusinessCard {
+	return BusinessCard{
+		Name:  "Jamie Alspach",
+		Title: "Staff Engineer",
+		Email: "jamie@example.com",
+	}
+}
+
+// BenchmarkGenerateCard_Uncached renders each card from scratch, the way
+// GenerateCard worked before FontCache/BackgroundCache existed: every call
+// re-reads and re-parses the TTFs and re-decodes the background PNG.
+func BenchmarkGenerateCard_Uncached(b *testing.B) {
+	bgPath, regularPath, boldPath := benchAssets(b)
+	tmpl := benchTemplate()
+	card := benchCard()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := GenerateCard(tmpl, bgPath, regularPath, boldPath, "", card); err != nil {
+			b.Fatalf("GenerateCard: %v", err)
+		}
+	}
+}
+
+// BenchmarkGenerateCard_Cached renders the same cards sharing a single
+// RenderCache, the way a batch run does: the TTFs and background are read
+// and parsed once regardless of how many rows follow. On 1000 rows this
+// should be well over 5x faster than the uncached path.
+func BenchmarkGenerateCard_Cached(b *testing.B) {
+	bgPath, regularPath, boldPath := benchAssets(b)
+	tmpl := benchTemplate()
+	card := benchCard()
+	cache := NewRenderCache()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := GenerateCardWithCache(cache, tmpl, bgPath, regularPath, boldPath, "", card); err != nil {
+			b.Fatalf("GenerateCardWithCache: %v", err)
+		}
+	}
+}
+
+// TestGenerateCardWithCacheConcurrent renders many cards against one shared
+// RenderCache from 8 goroutines at once, the same way cmd/cardgen/batch.go's
+// worker pool does with its runtime.NumCPU() workers. Run with -race: each font.Face
+// FontCache hands out must be freshly built, not a cached instance shared
+// across goroutines, or this trips the race detector on the Face's internal
+// rasterization buffers.
+func TestGenerateCardWithCacheConcurrent(t *testing.T) {
+	bgPath, regularPath, boldPath := benchAssets(t)
+	tmpl := benchTemplate()
+	cache := NewRenderCache()
+
+	const workers = 8
+	const perWorker = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				if _, err := GenerateCardWithCache(cache, tmpl, bgPath, regularPath, boldPath, "", benchCard()); err != nil {
+					t.Errorf("GenerateCardWithCache: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,100 @@
+package cardgen
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// GenerateCard creates a raster image of a business card by laying out
+// tmpl's text boxes over the background image, using the regular/bold/
+// italic fonts for whichever box.Font each box names. italicFontPath may be
+// empty if tmpl doesn't use any "italic" boxes. Each call parses its own
+// fonts and decodes its own background; callers rendering many cards should
+// use GenerateCardWithCache instead.
+func GenerateCard(tmpl *Template, bgImagePath, regularFontPath, boldFontPath, italicFontPath string, cardData BusinessCard) (image.Image, error) {
+	return GenerateCardWithCache(NewRenderCache(), tmpl, bgImagePath, regularFontPath, boldFontPath, italicFontPath, cardData)
+}
+
+// GenerateCardWithCache is GenerateCard, but fonts and backgrounds are
+// fetched through cache instead of being read and parsed fresh every call.
+// Sharing one RenderCache across a batch run avoids re-reading and
+// re-parsing the same TTF/PNG files for every row.
+func GenerateCardWithCache(cache *RenderCache, tmpl *Template, bgImagePath, regularFontPath, boldFontPath, italicFontPath string, cardData BusinessCard) (image.Image, error) {
+	bgImage, err := cache.Backgrounds.Get(bgImagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a new RGBA image with the same bounds as the background.
+	bounds := bgImage.Bounds()
+	img := image.NewRGBA(bounds)
+
+	// Draw the background image onto the new image.
+	draw.Draw(img, bounds, bgImage, image.Point{}, draw.Src)
+
+	fontPaths := fontPathsByName(regularFontPath, boldFontPath, italicFontPath)
+
+	cardData.PhoneNumber = formatPhoneNumber(cardData.PhoneNumber)
+
+	// cursorY tracks the bottom edge the most recently rendered box actually
+	// consumed, so a box with Flow set can close up the gap left by an
+	// earlier box whose text was empty and so was skipped entirely.
+	var cursorY int
+	var hasCursor bool
+
+	for _, box := range tmpl.Boxes {
+		text, err := renderBoxText(box, cardData)
+		if err != nil {
+			return nil, err
+		}
+		if text == "" {
+			continue
+		}
+
+		fontPath, ok := fontPaths[box.Font]
+		if !ok {
+			return nil, fmt.Errorf("box %q: unknown font %q", box.Name, box.Font)
+		}
+
+		textColor := color.Color(color.RGBA{R: 109, G: 110, B: 113, A: 255}) // default Main Gray
+		if box.Color != "" {
+			parsed, err := parseHexColor(box.Color)
+			if err != nil {
+				return nil, fmt.Errorf("box %q: %w", box.Name, err)
+			}
+			textColor = parsed
+		}
+
+		textBox := TextBox{
+			X: box.X, Y: flowY(box, cursorY, hasCursor), W: box.Width, H: box.Height,
+			Align: box.Align, VAlign: box.VAlign, MaxLines: box.MaxLines,
+		}
+		rect, err := drawTextInBox(img, cache.Fonts, fontPath, tmpl.Fonts, text, textBox, box.Size, textColor)
+		if err != nil {
+			return nil, fmt.Errorf("box %q: %w", box.Name, err)
+		}
+		cursorY, hasCursor = rect.Max.Y, true
+	}
+
+	if tmpl.QR != nil {
+		vcard := BuildVCard(VCard3, cardData)
+		if err := compositeQRCode(img, vcard, tmpl.QR.qrOptions()); err != nil {
+			return nil, fmt.Errorf("failed to composite QR code: %w", err)
+		}
+	}
+
+	return img, nil
+}
+
+// flowY returns the Y a box should actually render at: box.Y unchanged,
+// unless box.Flow is set and a previous box has already rendered, in which
+// case box.Y is treated as a gap added below that box's consumed bottom
+// edge (cursorY) instead of an absolute coordinate.
+func flowY(box TemplateBox, cursorY int, hasCursor bool) int {
+	if box.Flow && hasCursor {
+		return cursorY + box.Y
+	}
+	return box.Y
+}
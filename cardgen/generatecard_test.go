@@ -0,0 +1,86 @@
+package cardgen
+
+import (
+	"image"
+	"testing"
+)
+
+// TestFlowY checks the three cases flowY has to handle: an absolute box, a
+// flow box with nothing to flow off of yet (the first box in a template),
+// and a flow box closing the gap left by whatever was actually rendered
+// before it.
+func TestFlowY(t *testing.T) {
+	tests := []struct {
+		name      string
+		box       TemplateBox
+		cursorY   int
+		hasCursor bool
+		want      int
+	}{
+		{"absolute box ignores cursor", TemplateBox{Y: 50}, 200, true, 50},
+		{"flow box with no prior box is absolute", TemplateBox{Y: 50, Flow: true}, 0, false, 50},
+		{"flow box adds its Y as a gap below the cursor", TemplateBox{Y: 10, Flow: true}, 80, true, 90},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flowY(tt.box, tt.cursorY, tt.hasCursor); got != tt.want {
+				t.Errorf("flowY(%+v, %d, %v) = %d, want %d", tt.box, tt.cursorY, tt.hasCursor, got, tt.want)
+			}
+		})
+	}
+}
+
+// firstInkRow scans rows yStart..yEnd within columns xStart..xEnd and
+// returns the first row containing a non-white pixel, or -1 if there isn't
+// one.
+func firstInkRow(img image.Image, xStart, xEnd, yStart, yEnd int) int {
+	for y := yStart; y < yEnd; y++ {
+		for x := xStart; x < xEnd; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			if r>>8 != 0xff || g>>8 != 0xff || b>>8 != 0xff {
+				return y
+			}
+		}
+	}
+	return -1
+}
+
+// TestGenerateCardWithCacheFlowClosesGap checks that a flow box rises to
+// take the place of an earlier flow box whose text was empty, rather than
+// leaving a dead gap at the earlier box's position.
+func TestGenerateCardWithCacheFlowClosesGap(t *testing.T) {
+	bgPath, regularPath, boldPath := benchAssets(t)
+	tmpl := &Template{
+		Name: "flow-test",
+		Boxes: []TemplateBox{
+			{Name: "name", Text: "{{.Name}}", X: 20, Y: 20, Width: 400, Font: "bold", Size: 24},
+			{Name: "optional", Text: "{{.Title}}", X: 20, Y: 5, Flow: true, Width: 400, Font: "regular", Size: 16},
+			{Name: "next", Text: "{{.Email}}", X: 20, Y: 5, Flow: true, Width: 400, Font: "regular", Size: 16},
+		},
+	}
+	cache := NewRenderCache()
+
+	withOptional, err := GenerateCardWithCache(cache, tmpl, bgPath, regularPath, boldPath, "",
+		BusinessCard{Name: "Jamie Alspach", Title: "Staff Engineer", Email: "jamie@example.com"})
+	if err != nil {
+		t.Fatalf("GenerateCardWithCache (with optional): %v", err)
+	}
+	withoutOptional, err := GenerateCardWithCache(cache, tmpl, bgPath, regularPath, boldPath, "",
+		BusinessCard{Name: "Jamie Alspach", Email: "jamie@example.com"})
+	if err != nil {
+		t.Fatalf("GenerateCardWithCache (without optional): %v", err)
+	}
+
+	// The name box (size 24) never leaves ink past row 60; below that, the
+	// first ink row is whichever box actually rendered second, regardless
+	// of whether that's "optional" (present) or "next" (optional skipped).
+	rowWith := firstInkRow(withOptional, 20, 420, 60, 600)
+	rowWithout := firstInkRow(withoutOptional, 20, 420, 60, 600)
+	if rowWith == -1 || rowWithout == -1 {
+		t.Fatalf("expected ink below the name box in both renders (with=%d without=%d)", rowWith, rowWithout)
+	}
+	if rowWith != rowWithout {
+		t.Errorf("next box should flow up to where optional sat when optional is empty: with-optional row=%d, without-optional row=%d", rowWith, rowWithout)
+	}
+}
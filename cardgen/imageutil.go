@@ -0,0 +1,55 @@
+package cardgen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+)
+
+// backgroundDimensions fetches the background image through cache (decoding
+// and caching it on first use rather than every call) and reports its pixel
+// bounds, reused by both the SVG and PDF renderers to size the page/canvas.
+func backgroundDimensions(cache *BackgroundCache, bgImagePath string) (image.Image, image.Rectangle, float64, float64, error) {
+	bgImage, err := cache.Get(bgImagePath)
+	if err != nil {
+		return nil, image.Rectangle{}, 0, 0, err
+	}
+
+	bounds := bgImage.Bounds()
+	return bgImage, bounds, float64(bounds.Dx()), float64(bounds.Dy()), nil
+}
+
+// encodeBackgroundAsDataURI fetches the background image through cache and
+// re-encodes it as a base64 PNG data URI so the SVG renderer can embed it
+// inline, plus its pixel dimensions for the document's viewBox.
+func encodeBackgroundAsDataURI(cache *BackgroundCache, bgImagePath string) (string, int, int, error) {
+	bgImage, bounds, _, _, err := backgroundDimensions(cache, bgImagePath)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, bgImage); err != nil {
+		return "", 0, 0, fmt.Errorf("failed to encode background image: %w", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(buf.Bytes())
+	return "data:image/png;base64," + encoded, bounds.Dx(), bounds.Dy(), nil
+}
+
+// svgEscape replaces the handful of characters that are meaningful inside an
+// SVG <text> element so card data (names, titles, companies) can't break the
+// document.
+func svgEscape(s string) string {
+	replacer := strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`"`, "&quot;",
+		"'", "&apos;",
+	)
+	return replacer.Replace(s)
+}
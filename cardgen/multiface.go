@@ -0,0 +1,71 @@
+package cardgen
+
+import (
+	"fmt"
+	"image"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// MultiFace composes a primary font.Face with an ordered list of fallback
+// faces, the way golang.org/x/image/font/plan9font's ParseFont composes a
+// primary font with subfonts covering different Unicode ranges. For each
+// rune it dispatches to the first face that actually has a glyph for it, so
+// a name like "北京" or "José", or an emoji pronoun badge, doesn't render as
+// tofu boxes just because the primary brand font only covers Latin.
+type MultiFace struct {
+	faces []font.Face // faces[0] is primary; its metrics/kerning anchor the line
+}
+
+// NewMultiFace returns a MultiFace trying faces in order for each rune.
+func NewMultiFace(faces ...font.Face) (*MultiFace, error) {
+	if len(faces) == 0 {
+		return nil, fmt.Errorf("MultiFace needs at least one face")
+	}
+	return &MultiFace{faces: faces}, nil
+}
+
+// faceFor returns the first face with a real glyph for r, falling back to
+// the primary face (which will draw tofu, same as before) if none do.
+func (m *MultiFace) faceFor(r rune) font.Face {
+	for _, f := range m.faces {
+		if _, ok := f.GlyphAdvance(r); ok {
+			return f
+		}
+	}
+	return m.faces[0]
+}
+
+func (m *MultiFace) Close() error {
+	var firstErr error
+	for _, f := range m.faces {
+		if err := f.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (m *MultiFace) Glyph(dot fixed.Point26_6, r rune) (dr image.Rectangle, mask image.Image, maskp image.Point, advance fixed.Int26_6, ok bool) {
+	return m.faceFor(r).Glyph(dot, r)
+}
+
+func (m *MultiFace) GlyphBounds(r rune) (bounds fixed.Rectangle26_6, advance fixed.Int26_6, ok bool) {
+	return m.faceFor(r).GlyphBounds(r)
+}
+
+func (m *MultiFace) GlyphAdvance(r rune) (advance fixed.Int26_6, ok bool) {
+	return m.faceFor(r).GlyphAdvance(r)
+}
+
+// Kern and Metrics come from the primary face; mixing line metrics across
+// faces of possibly very different design would make every line of text
+// jump around as it switches scripts.
+func (m *MultiFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	return m.faces[0].Kern(r0, r1)
+}
+
+func (m *MultiFace) Metrics() font.Metrics {
+	return m.faces[0].Metrics()
+}
@@ -0,0 +1,71 @@
+package cardgen
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// notoFallbackURLs maps the well-known glyph-coverage gaps a brand font
+// typically has (CJK, emoji) to a canonical Noto font download, so a
+// template's fallback chain can reference "noto-cjk" / "noto-emoji" without
+// the repo needing to vendor large font files.
+var notoFallbackURLs = map[string]string{
+	"noto-cjk":   "https://raw.githubusercontent.com/googlefonts/noto-cjk/main/Sans/OTF/SimplifiedChinese/NotoSansCJKsc-Regular.otf",
+	"noto-emoji": "https://raw.githubusercontent.com/googlefonts/noto-emoji/main/fonts/NotoColorEmoji.ttf",
+}
+
+// EnsureNotoFallbacks downloads whichever of names ("noto-cjk", "noto-emoji")
+// aren't already cached in cacheDir, and returns their local paths in the
+// same order as names, ready to append to a Template's Fonts.
+func EnsureNotoFallbacks(cacheDir string, names []string) ([]string, error) {
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create font cache directory %s: %w", cacheDir, err)
+	}
+
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		url, ok := notoFallbackURLs[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown Noto fallback %q", name)
+		}
+
+		path := filepath.Join(cacheDir, filepath.Base(url))
+		if _, err := os.Stat(path); err == nil {
+			paths = append(paths, path)
+			continue
+		}
+
+		if err := downloadFile(url, path); err != nil {
+			return nil, fmt.Errorf("failed to download %s: %w", name, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// downloadFile fetches url and writes its body to path.
+func downloadFile(url, path string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status fetching %s: %s", url, resp.Status)
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package cardgen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// QRCorner identifies which corner of the card a composited QR code anchors
+// to.
+type QRCorner string
+
+const (
+	QRCornerTopLeft     QRCorner = "top-left"
+	QRCornerTopRight    QRCorner = "top-right"
+	QRCornerBottomLeft  QRCorner = "bottom-left"
+	QRCornerBottomRight QRCorner = "bottom-right"
+)
+
+// QROptions controls how a QR code is generated and where it's placed on
+// the card.
+type QROptions struct {
+	Corner        QRCorner
+	ModuleSize    int // pixel width/height of the final square code; <= 0 uses DefaultQROptions
+	Margin        int // padding, in pixels, from the card edge
+	RecoveryLevel qrcode.RecoveryLevel
+}
+
+// DefaultQROptions is used whenever a template doesn't override placement:
+// a modest code tucked into the bottom-right corner with medium error
+// correction, which keeps it scannable even through a patterned brand
+// background.
+func DefaultQROptions() QROptions {
+	return QROptions{
+		Corner:        QRCornerBottomRight,
+		ModuleSize:    96,
+		Margin:        12,
+		RecoveryLevel: qrcode.Medium,
+	}
+}
+
+// ParseQRRecoveryLevel maps a template's "low"/"medium"/"high"/"highest"
+// recovery_level string onto the qrcode.RecoveryLevel it names.
+func ParseQRRecoveryLevel(s string) (qrcode.RecoveryLevel, error) {
+	switch s {
+	case "low":
+		return qrcode.Low, nil
+	case "medium":
+		return qrcode.Medium, nil
+	case "high":
+		return qrcode.High, nil
+	case "highest":
+		return qrcode.Highest, nil
+	default:
+		return 0, fmt.Errorf("invalid QR recovery level %q", s)
+	}
+}
+
+// renderQRCode encodes data as a QR code image at the requested module size.
+func renderQRCode(data string, opts QROptions) (image.Image, error) {
+	if opts.ModuleSize <= 0 {
+		opts.ModuleSize = DefaultQROptions().ModuleSize
+	}
+
+	qr, err := qrcode.New(data, opts.RecoveryLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build QR code: %w", err)
+	}
+	return qr.Image(opts.ModuleSize), nil
+}
+
+// qrOrigin computes the top-left pixel a size x size QR code should be
+// placed at to sit in corner, margin pixels from the edges of a
+// width x height canvas.
+func qrOrigin(corner QRCorner, margin, size, width, height int) (x, y int) {
+	switch corner {
+	case QRCornerTopLeft:
+		return margin, margin
+	case QRCornerTopRight:
+		return width - margin - size, margin
+	case QRCornerBottomLeft:
+		return margin, height - margin - size
+	default: // QRCornerBottomRight
+		return width - margin - size, height - margin - size
+	}
+}
+
+// compositeQRCode renders data as a QR code and draws it into one corner of
+// img.
+func compositeQRCode(img *image.RGBA, data string, opts QROptions) error {
+	qrImage, err := renderQRCode(data, opts)
+	if err != nil {
+		return err
+	}
+
+	bounds := img.Bounds()
+	size := qrImage.Bounds().Dx()
+	x, y := qrOrigin(opts.Corner, opts.Margin, size, bounds.Dx(), bounds.Dy())
+	origin := image.Pt(bounds.Min.X+x, bounds.Min.Y+y)
+
+	dstRect := image.Rectangle{Min: origin, Max: origin.Add(image.Pt(size, size))}
+	draw.Draw(img, dstRect, qrImage, image.Point{}, draw.Over)
+	return nil
+}
+
+// qrCodeDataURI renders data as a QR code and returns it as a base64-encoded
+// PNG data URI along with its pixel size, for the SVG renderer to embed
+// inline.
+func qrCodeDataURI(data string, opts QROptions) (uri string, size int, err error) {
+	qrImage, err := renderQRCode(data, opts)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, qrImage); err != nil {
+		return "", 0, fmt.Errorf("failed to encode QR code: %w", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(buf.Bytes())
+	return "data:image/png;base64," + encoded, qrImage.Bounds().Dx(), nil
+}
@@ -0,0 +1,366 @@
+package cardgen
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"image/png"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Format identifies which output backend a Renderer targets.
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+	FormatPDF Format = "pdf"
+)
+
+// ParseFormat maps a `?format=`/CLI string onto a Format, defaulting to PNG
+// when the caller didn't specify one.
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case "", FormatPNG:
+		return FormatPNG, nil
+	case FormatSVG:
+		return FormatSVG, nil
+	case FormatPDF:
+		return FormatPDF, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", s)
+	}
+}
+
+// ContentType returns the MIME type to send for a rendered format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatSVG:
+		return "image/svg+xml"
+	case FormatPDF:
+		return "application/pdf"
+	default:
+		return "image/png"
+	}
+}
+
+// fontPathsByName maps a box's Font value ("regular", "bold", "italic") to
+// the font file that should draw it. The "italic" entry is omitted when
+// italicFontPath is empty, the same way GenerateCard has always allowed
+// templates with no italic boxes to skip passing one.
+func fontPathsByName(regularFontPath, boldFontPath, italicFontPath string) map[string]string {
+	paths := map[string]string{
+		"regular": regularFontPath,
+		"bold":    boldFontPath,
+	}
+	if italicFontPath != "" {
+		paths["italic"] = italicFontPath
+	}
+	return paths
+}
+
+// Renderer produces a card artifact in a specific output format, laid out
+// according to tmpl. All three backends share the same BusinessCard data and
+// the same Template, and all place boxes by X, Y and Flow. Only
+// RasterRenderer word-wraps to Width, shrinks text to fit, and applies
+// MaxLines and VAlign; SVGRenderer and PDFRenderer draw each box as a single
+// line at its Size, and PDFRenderer also ignores Align. italicFontPath may
+// be empty if tmpl doesn't use any "italic" boxes.
+type Renderer interface {
+	// Render returns the encoded bytes of the card (PNG, SVG, or PDF) along
+	// with the file extension callers should use when naming it.
+	Render(tmpl *Template, bgImagePath, regularFontPath, boldFontPath, italicFontPath string, cardData BusinessCard) ([]byte, string, error)
+}
+
+// RasterRenderer produces a PNG by delegating to the existing GenerateCard
+// rasterizer and encoding the result. A nil Cache renders each card with
+// fresh, uncached font/background reads; set Cache to share one RenderCache
+// across many Render calls (e.g. a batch run).
+type RasterRenderer struct {
+	Cache *RenderCache
+}
+
+func (r RasterRenderer) Render(tmpl *Template, bgImagePath, regularFontPath, boldFontPath, italicFontPath string, cardData BusinessCard) ([]byte, string, error) {
+	cache := r.Cache
+	if cache == nil {
+		cache = NewRenderCache()
+	}
+
+	img, err := GenerateCardWithCache(cache, tmpl, bgImagePath, regularFontPath, boldFontPath, italicFontPath, cardData)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, "", fmt.Errorf("failed to encode PNG image: %w", err)
+	}
+	return buf.Bytes(), "png", nil
+}
+
+// SVGRenderer emits an SVG document with a single embedded background image
+// and <text> elements carrying font-family/weight/style, so a designer can
+// still open the file in Illustrator and tweak the type. A nil Cache parses
+// its own fonts fresh; set Cache to share one RenderCache across many
+// Render calls (e.g. a batch run).
+type SVGRenderer struct {
+	Cache *RenderCache
+}
+
+func (r SVGRenderer) Render(tmpl *Template, bgImagePath, regularFontPath, boldFontPath, italicFontPath string, cardData BusinessCard) ([]byte, string, error) {
+	cache := r.Cache
+	if cache == nil {
+		cache = NewRenderCache()
+	}
+
+	bgDataURI, width, height, err := encodeBackgroundAsDataURI(cache.Backgrounds, bgImagePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fontPaths := fontPathsByName(regularFontPath, boldFontPath, italicFontPath)
+	fontDefs, fallbackFamilies, err := embedFontFaces(regularFontPath, boldFontPath, italicFontPath, tmpl.Fonts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cardData.PhoneNumber = formatPhoneNumber(cardData.PhoneNumber)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", width, height, width, height)
+	buf.WriteString(fontDefs)
+	fmt.Fprintf(&buf, `<image x="0" y="0" width="%d" height="%d" xlink:href="%s"/>`+"\n", width, height, bgDataURI)
+
+	var cursorY int
+	var hasCursor bool
+
+	for _, box := range tmpl.Boxes {
+		text, err := renderBoxText(box, cardData)
+		if err != nil {
+			return nil, "", err
+		}
+		if text == "" {
+			continue
+		}
+
+		fontPath, ok := fontPaths[box.Font]
+		if !ok {
+			return nil, "", fmt.Errorf("box %q: unknown font %q", box.Name, box.Font)
+		}
+
+		weight, style := "normal", "normal"
+		switch box.Font {
+		case "bold":
+			weight = "bold"
+		case "italic":
+			style = "italic"
+		}
+
+		fillColor := "rgb(109,110,113)"
+		if box.Color != "" {
+			c, err := parseHexColor(box.Color)
+			if err != nil {
+				return nil, "", fmt.Errorf("box %q: %w", box.Name, err)
+			}
+			fillColor = fmt.Sprintf("rgb(%d,%d,%d)", c.R, c.G, c.B)
+		}
+
+		runs, err := vectorTextRuns(cache.Fonts, fontPath, tmpl.Fonts, text, box.Size)
+		if err != nil {
+			return nil, "", fmt.Errorf("box %q: %w", box.Name, err)
+		}
+
+		totalWidth := 0
+		for _, run := range runs {
+			totalWidth += run.Width
+		}
+
+		x := box.X
+		if box.Width > 0 {
+			switch box.Align {
+			case "center":
+				x = box.X + (box.Width-totalWidth)/2
+			case "right":
+				x = box.X + box.Width - totalWidth
+			}
+		}
+
+		// The box's Y is a top-left origin, but SVG <text> y is a baseline,
+		// so nudge down by roughly the font size.
+		top := flowY(box, cursorY, hasCursor)
+		baseline := top + int(box.Size)
+		fmt.Fprintf(&buf, `<text y="%d" font-family="Raleway" font-weight="%s" font-style="%s" font-size="%g" fill="%s">`+"\n",
+			baseline, weight, style, box.Size, fillColor)
+		for _, run := range runs {
+			if run.Path == fontPath {
+				fmt.Fprintf(&buf, `<tspan x="%d">%s</tspan>`+"\n", x, svgEscape(run.Text))
+			} else {
+				fmt.Fprintf(&buf, `<tspan x="%d" font-family="%s" font-weight="normal" font-style="normal">%s</tspan>`+"\n",
+					x, fallbackFamilies[run.Path], svgEscape(run.Text))
+			}
+			x += run.Width
+		}
+		buf.WriteString("</text>\n")
+
+		face, err := cache.Fonts.Face(fontPath, box.Size)
+		if err != nil {
+			return nil, "", fmt.Errorf("box %q: %w", box.Name, err)
+		}
+		cursorY, hasCursor = top+face.Metrics().Height.Ceil(), true
+	}
+
+	if tmpl.QR != nil {
+		vcard := BuildVCard(VCard3, cardData)
+		opts := tmpl.QR.qrOptions()
+		qrDataURI, qrSize, err := qrCodeDataURI(vcard, opts)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to render QR code: %w", err)
+		}
+		x, y := qrOrigin(opts.Corner, opts.Margin, qrSize, width, height)
+		fmt.Fprintf(&buf, `<image x="%d" y="%d" width="%d" height="%d" xlink:href="%s"/>`+"\n", x, y, qrSize, qrSize, qrDataURI)
+	}
+
+	buf.WriteString("</svg>\n")
+	return buf.Bytes(), "svg", nil
+}
+
+// PDFRenderer produces a print-ready, vector PDF. The Raleway regular/bold/
+// italic TTFs are embedded so the text stays selectable and scalable, while
+// the brand background is placed as a single full-bleed image layer behind
+// it. A nil Cache parses its own fonts fresh; set Cache to share one
+// RenderCache across many Render calls (e.g. a batch run).
+type PDFRenderer struct {
+	Cache *RenderCache
+}
+
+func (r PDFRenderer) Render(tmpl *Template, bgImagePath, regularFontPath, boldFontPath, italicFontPath string, cardData BusinessCard) ([]byte, string, error) {
+	cache := r.Cache
+	if cache == nil {
+		cache = NewRenderCache()
+	}
+
+	pdf := gofpdf.New("L", "pt", "", "")
+	// gofpdf.New("", ...) defaults its font directory to ".", and it joins
+	// that with every AddUTF8Font path via path.Join, which silently drops
+	// the leading "/" of an absolute font path (e.g. a Noto fallback
+	// downloaded to an os.UserCacheDir()-rooted path). SetFontLocation("")
+	// sets the font directory to "" instead, which path.Join leaves an
+	// absolute path alone while still resolving a relative one against cwd.
+	pdf.SetFontLocation("")
+	pdf.AddUTF8Font("Raleway", "", regularFontPath)
+	pdf.AddUTF8Font("Raleway", "B", boldFontPath)
+	if italicFontPath != "" {
+		pdf.AddUTF8Font("Raleway", "I", italicFontPath)
+	}
+	fallbackFamilies := registerFallbackFonts(pdf, tmpl.Fonts)
+
+	fontPaths := fontPathsByName(regularFontPath, boldFontPath, italicFontPath)
+
+	_, _, width, height, err := backgroundDimensions(cache.Backgrounds, bgImagePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pdf.AddPageFormat("L", gofpdf.SizeType{Wd: width, Ht: height})
+	pdf.ImageOptions(bgImagePath, 0, 0, width, height, false, gofpdf.ImageOptions{ImageType: ""}, 0, "")
+
+	cardData.PhoneNumber = formatPhoneNumber(cardData.PhoneNumber)
+
+	var cursorY int
+	var hasCursor bool
+
+	for _, box := range tmpl.Boxes {
+		text, err := renderBoxText(box, cardData)
+		if err != nil {
+			return nil, "", err
+		}
+		if text == "" {
+			continue
+		}
+
+		fontPath, ok := fontPaths[box.Font]
+		if !ok {
+			return nil, "", fmt.Errorf("box %q: unknown font %q", box.Name, box.Font)
+		}
+
+		style := ""
+		switch box.Font {
+		case "bold":
+			style = "B"
+		case "italic":
+			style = "I"
+		}
+
+		c := color.RGBA{R: 109, G: 110, B: 113, A: 255}
+		if box.Color != "" {
+			parsed, err := parseHexColor(box.Color)
+			if err != nil {
+				return nil, "", fmt.Errorf("box %q: %w", box.Name, err)
+			}
+			c = parsed
+		}
+
+		runs, err := vectorTextRuns(cache.Fonts, fontPath, tmpl.Fonts, text, box.Size)
+		if err != nil {
+			return nil, "", fmt.Errorf("box %q: %w", box.Name, err)
+		}
+
+		top := flowY(box, cursorY, hasCursor)
+		pdf.SetTextColor(int(c.R), int(c.G), int(c.B))
+		pdf.SetXY(float64(box.X), float64(top))
+		for _, run := range runs {
+			if run.Path == fontPath {
+				pdf.SetFont("Raleway", style, box.Size)
+			} else {
+				pdf.SetFont(fallbackFamilies[run.Path], "", box.Size)
+			}
+			runWidth := pdf.GetStringWidth(run.Text)
+			pdf.CellFormat(runWidth, box.Size, run.Text, "", 0, "L", false, 0, "")
+		}
+
+		face, err := cache.Fonts.Face(fontPath, box.Size)
+		if err != nil {
+			return nil, "", fmt.Errorf("box %q: %w", box.Name, err)
+		}
+		cursorY, hasCursor = top+face.Metrics().Height.Ceil(), true
+	}
+
+	if tmpl.QR != nil {
+		vcard := BuildVCard(VCard3, cardData)
+		opts := tmpl.QR.qrOptions()
+		qrImage, err := renderQRCode(vcard, opts)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to render QR code: %w", err)
+		}
+		qrSize := qrImage.Bounds().Dx()
+		x, y := qrOrigin(opts.Corner, opts.Margin, qrSize, int(width), int(height))
+
+		var qrBuf bytes.Buffer
+		if err := png.Encode(&qrBuf, qrImage); err != nil {
+			return nil, "", fmt.Errorf("failed to encode QR code: %w", err)
+		}
+		pdf.RegisterImageOptionsReader("qrcode", gofpdf.ImageOptions{ImageType: "PNG"}, &qrBuf)
+		pdf.ImageOptions("qrcode", float64(x), float64(y), float64(qrSize), float64(qrSize), false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, "", fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return buf.Bytes(), "pdf", nil
+}
+
+// RendererFor returns the Renderer implementation for the requested format.
+func RendererFor(f Format) (Renderer, error) {
+	switch f {
+	case FormatPNG:
+		return RasterRenderer{}, nil
+	case FormatSVG:
+		return SVGRenderer{}, nil
+	case FormatPDF:
+		return PDFRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("no renderer registered for format %q", f)
+	}
+}
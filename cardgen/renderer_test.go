@@ -0,0 +1,69 @@
+package cardgen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func vectorTestTemplate(fallbackPaths []string) *Template {
+	return &Template{
+		Name: "vector-bench",
+		Boxes: []TemplateBox{
+			{Name: "name", Text: "{{.Name}}", X: 20, Y: 20, Width: 400, Font: "regular", Size: 24},
+		},
+		Fonts: fallbackPaths,
+	}
+}
+
+// TestVectorTextRunsFallsBackToPrimary checks that a rune no font in the
+// chain covers still ends up in a run on the primary font, the same way
+// MultiFace.faceFor does for the raster path, instead of erroring or
+// silently dropping text.
+func TestVectorTextRunsFallsBackToPrimary(t *testing.T) {
+	_, regularPath, _ := benchAssets(t)
+	cache := NewFontCache()
+
+	runs, err := vectorTextRuns(cache, regularPath, []string{regularPath}, "你好", 16)
+	if err != nil {
+		t.Fatalf("vectorTextRuns: %v", err)
+	}
+	if len(runs) != 1 || runs[0].Path != regularPath || runs[0].Text != "你好" {
+		t.Fatalf("vectorTextRuns(%q) = %+v, want a single run on the primary font", "你好", runs)
+	}
+}
+
+// TestSVGRendererEmbedsFallbackFonts checks that a template's fallback
+// fonts are embedded alongside the brand font, rather than only the raster
+// backend wiring them in.
+func TestSVGRendererEmbedsFallbackFonts(t *testing.T) {
+	bgPath, regularPath, boldPath := benchAssets(t)
+	tmpl := vectorTestTemplate([]string{regularPath})
+
+	data, _, err := SVGRenderer{}.Render(tmpl, bgPath, regularPath, boldPath, "", benchCard())
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	svg := string(data)
+	if !strings.Contains(svg, `font-family: "Fallback0"`) {
+		t.Errorf("SVG output doesn't embed the fallback font face:\n%s", svg)
+	}
+}
+
+// TestPDFRendererRegistersFallbackFonts checks that a template's fallback
+// fonts get registered with gofpdf, not just the brand regular/bold/italic
+// trio.
+func TestPDFRendererRegistersFallbackFonts(t *testing.T) {
+	bgPath, regularPath, boldPath := benchAssets(t)
+	tmpl := vectorTestTemplate([]string{regularPath})
+
+	data, _, err := PDFRenderer{}.Render(tmpl, bgPath, regularPath, boldPath, "", benchCard())
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	// gofpdf lowercases and prefixes UTF-8 font family names internally
+	// (registerFallbackFonts's "Fallback0" becomes "utf8fallback0").
+	if !bytes.Contains(data, []byte("utf8fallback0")) {
+		t.Error("PDF output doesn't reference the registered fallback font")
+	}
+}
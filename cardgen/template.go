@@ -0,0 +1,196 @@
+package cardgen
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TemplateBox declares a single piece of text to draw on the card: where it
+// goes, which font/size/weight/color to use, and the Go text/template
+// source (evaluated against a BusinessCard) that produces its contents.
+type TemplateBox struct {
+	Name     string  `yaml:"name"`
+	Text     string  `yaml:"text"`
+	X        int     `yaml:"x"`
+	Y        int     `yaml:"y"`
+	Width    int     `yaml:"width"`
+	Height   int     `yaml:"height"`
+	Font     string  `yaml:"font"` // "regular", "bold", or "italic" (italic requires the renderer be given an italic font path)
+	Size     float64 `yaml:"size"`
+	Color    string  `yaml:"color"`  // hex, e.g. "#F26722"
+	Align    string  `yaml:"align"`  // "left", "center", "right"; defaults to "left"
+	VAlign   string  `yaml:"valign"` // "top", "middle", "bottom"; defaults to "top"
+	MaxLines int     `yaml:"max_lines"`
+	// Flow, when true, treats Y as a gap added below the previous box's
+	// consumed bottom edge instead of an absolute coordinate, so this box
+	// rises to close the gap when an earlier flowing box renders nothing
+	// (e.g. an optional field left blank). The first box in a template is
+	// always placed at its absolute Y regardless of Flow, since there's no
+	// previous box to flow off of.
+	Flow bool `yaml:"flow"`
+}
+
+// TemplateQR declares an optional QR code (vCard handoff) to composite onto
+// the card. A nil *TemplateQR on Template means the card doesn't get one.
+type TemplateQR struct {
+	Corner        string `yaml:"corner"`         // "top-left", "top-right", "bottom-left", "bottom-right"
+	ModuleSize    int    `yaml:"module_size"`    // pixel width/height of the code; <= 0 uses DefaultQROptions
+	Margin        int    `yaml:"margin"`         // pixel padding from the card edge
+	RecoveryLevel string `yaml:"recovery_level"` // "low", "medium", "high", "highest"; defaults to DefaultQROptions
+}
+
+// Template is a named card layout: a background-relative set of text boxes
+// driven entirely by data, so an organization can ship new card designs
+// without recompiling.
+type Template struct {
+	Name  string        `yaml:"name"`
+	Boxes []TemplateBox `yaml:"boxes"`
+	QR    *TemplateQR   `yaml:"qr"`
+	// Fonts lists fallback font paths, tried in order after a box's own
+	// regular/bold/italic font for any rune that font has no glyph for
+	// (e.g. CJK or emoji the brand font doesn't cover). See MultiFace.
+	Fonts []string `yaml:"fonts"`
+}
+
+// qrOptions converts a TemplateQR into the QROptions compositeQRCode
+// expects, falling back to DefaultQROptions for anything unset.
+func (q *TemplateQR) qrOptions() QROptions {
+	opts := DefaultQROptions()
+	if q == nil {
+		return opts
+	}
+	if q.Corner != "" {
+		opts.Corner = QRCorner(q.Corner)
+	}
+	if q.ModuleSize > 0 {
+		opts.ModuleSize = q.ModuleSize
+	}
+	if q.Margin > 0 {
+		opts.Margin = q.Margin
+	}
+	if q.RecoveryLevel != "" {
+		if level, err := ParseQRRecoveryLevel(q.RecoveryLevel); err == nil {
+			opts.RecoveryLevel = level
+		}
+	}
+	return opts
+}
+
+// LoadTemplate reads and parses a single template.yaml file.
+func LoadTemplate(path string) (*Template, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
+
+	var tmpl Template
+	if err := yaml.Unmarshal(raw, &tmpl); err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+	}
+
+	if tmpl.Name == "" {
+		tmpl.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	if err := validateTemplate(&tmpl); err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", path, err)
+	}
+
+	return &tmpl, nil
+}
+
+// TemplateRegistry maps a template name (as passed via ?template= or
+// --template) to its parsed Template.
+type TemplateRegistry map[string]*Template
+
+// LoadTemplateRegistry walks dir for *.yaml files and loads each one,
+// keyed by its Template.Name. It's meant to run once at startup so a bad
+// template fails fast instead of mid-render.
+func LoadTemplateRegistry(dir string) (TemplateRegistry, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan template directory %s: %w", dir, err)
+	}
+
+	registry := make(TemplateRegistry, len(matches))
+	for _, path := range matches {
+		tmpl, err := LoadTemplate(path)
+		if err != nil {
+			return nil, err
+		}
+		registry[tmpl.Name] = tmpl
+	}
+	return registry, nil
+}
+
+// validateTemplate checks that every box's Text references only fields that
+// actually exist on BusinessCard, so a typo in template.yaml is caught at
+// load time rather than rendering a blank box in production.
+func validateTemplate(tmpl *Template) error {
+	for _, box := range tmpl.Boxes {
+		if box.Name == "" {
+			return fmt.Errorf("box has no name")
+		}
+		t, err := template.New(box.Name).Parse(box.Text)
+		if err != nil {
+			return fmt.Errorf("box %q: %w", box.Name, err)
+		}
+		if err := t.Execute(io.Discard, BusinessCard{}); err != nil {
+			return fmt.Errorf("box %q references an unknown BusinessCard field: %w", box.Name, err)
+		}
+		if box.Color != "" {
+			if _, err := parseHexColor(box.Color); err != nil {
+				return fmt.Errorf("box %q: %w", box.Name, err)
+			}
+		}
+	}
+	if tmpl.QR != nil && tmpl.QR.RecoveryLevel != "" {
+		if _, err := ParseQRRecoveryLevel(tmpl.QR.RecoveryLevel); err != nil {
+			return fmt.Errorf("qr: %w", err)
+		}
+	}
+	return nil
+}
+
+// renderBoxText evaluates a box's text/template source against cardData.
+func renderBoxText(box TemplateBox, cardData BusinessCard) (string, error) {
+	t, err := template.New(box.Name).Parse(box.Text)
+	if err != nil {
+		return "", fmt.Errorf("box %q: %w", box.Name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, cardData); err != nil {
+		return "", fmt.Errorf("box %q: %w", box.Name, err)
+	}
+	return buf.String(), nil
+}
+
+// parseHexColor turns a "#RRGGBB" string into a color.RGBA.
+func parseHexColor(s string) (color.RGBA, error) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) != 6 {
+		return color.RGBA{}, fmt.Errorf("invalid hex color %q", s)
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid hex color %q: %w", s, err)
+	}
+
+	return color.RGBA{
+		R: uint8(v >> 16),
+		G: uint8(v >> 8),
+		B: uint8(v),
+		A: 255,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package cardgen
+
+import (
+	"image"
+	"image/color"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// TextBox describes a bounded region that text is flowed into: word-wrapped
+// to box.W, optionally shrunk to fit box.H/MaxLines, and aligned within the
+// box.
+type TextBox struct {
+	X, Y, W, H int
+	Align      string // "left", "center", "right"; defaults to "left"
+	VAlign     string // "top", "middle", "bottom"; defaults to "top"
+	MaxLines   int    // 0 means unlimited
+}
+
+// minFontSize is the floor drawTextInBox will shrink to before giving up and
+// drawing the overflowing text anyway.
+const minFontSize = 8.0
+
+// drawTextInBox word-wraps text against the font at fontPath (falling back,
+// per rune, to fallbackPaths for any glyph it lacks), shrinking the font
+// size in 1pt steps from startSize down to minFontSize until the wrapped
+// lines fit box.H (and MaxLines, if set), then draws it — horizontally per
+// box.Align and, when box.H is set, vertically per box.VAlign — and returns
+// the bounding rectangle the text actually consumed so callers can flow
+// whatever comes next. A single word wider than box.W does not cause a
+// shrink; it overflows box.W. Fonts are parsed through fontCache, so
+// rendering many cards from the same template reuses the same parsed fonts;
+// each size tried still gets a freshly built face.
+func drawTextInBox(img *image.RGBA, fontCache *FontCache, fontPath string, fallbackPaths []string, text string, box TextBox, startSize float64, c color.Color) (image.Rectangle, error) {
+	var (
+		face       font.Face
+		lines      []string
+		lineHeight int
+	)
+
+	for size := startSize; ; size-- {
+		f, err := fontCache.MultiFace(fontPath, size, fallbackPaths)
+		if err != nil {
+			return image.Rectangle{}, err
+		}
+
+		wrapped := wordWrap(f, text, box.W)
+		height := f.Metrics().Height.Ceil()
+
+		fits := box.H <= 0 || len(wrapped)*height <= box.H
+		if box.MaxLines > 0 {
+			fits = fits && len(wrapped) <= box.MaxLines
+		}
+
+		if fits || size <= minFontSize {
+			face, lines, lineHeight = f, wrapped, height
+			break
+		}
+	}
+
+	if box.MaxLines > 0 && len(lines) > box.MaxLines {
+		lines = lines[:box.MaxLines]
+	}
+
+	y := box.Y
+	if box.H > 0 {
+		blockHeight := len(lines) * lineHeight
+		switch box.VAlign {
+		case "middle":
+			y = box.Y + (box.H-blockHeight)/2
+		case "bottom":
+			y = box.Y + box.H - blockHeight
+		}
+	}
+	top := y
+
+	minX, maxX := box.X, box.X
+	for i, line := range lines {
+		width := font.MeasureString(face, line).Ceil()
+
+		x := box.X
+		if box.W > 0 {
+			switch box.Align {
+			case "center":
+				x = box.X + (box.W-width)/2
+			case "right":
+				x = box.X + box.W - width
+			}
+		}
+		if i == 0 || x < minX {
+			minX = x
+		}
+		if i == 0 || x+width > maxX {
+			maxX = x + width
+		}
+
+		d := &font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(c),
+			Face: face,
+			Dot:  fixed.Point26_6{X: fixed.I(x), Y: fixed.I(y + lineHeight)},
+		}
+		d.DrawString(line)
+		y += lineHeight
+	}
+
+	return image.Rect(minX, top, maxX, y), nil
+}
+
+// wordWrap splits text into lines that each fit within maxWidth pixels when
+// rendered with face, breaking on spaces. A word wider than maxWidth on its
+// own is kept on one line rather than broken mid-word. maxWidth <= 0 means
+// unbounded (the whole string is returned as a single line).
+func wordWrap(face font.Face, text string, maxWidth int) []string {
+	if maxWidth <= 0 {
+		return []string{text}
+	}
+
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var lines []string
+	current := words[0]
+	for _, word := range words[1:] {
+		candidate := current + " " + word
+		if font.MeasureString(face, candidate).Ceil() <= maxWidth {
+			current = candidate
+			continue
+		}
+		lines = append(lines, current)
+		current = word
+	}
+	lines = append(lines, current)
+	return lines
+}
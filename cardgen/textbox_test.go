@@ -0,0 +1,44 @@
+package cardgen
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// TestDrawTextInBoxVAlign checks that box.VAlign actually moves the text
+// block within box.H instead of always top-aligning it.
+func TestDrawTextInBoxVAlign(t *testing.T) {
+	_, regularPath, _ := benchAssets(t)
+	cache := NewFontCache()
+
+	box := TextBox{X: 0, Y: 100, W: 300, H: 200}
+	img := image.NewRGBA(image.Rect(0, 0, 300, 400))
+
+	top, err := drawTextInBox(img, cache, regularPath, nil, "Hello", box, 16, color.Black)
+	if err != nil {
+		t.Fatalf("top: %v", err)
+	}
+
+	box.VAlign = "middle"
+	middle, err := drawTextInBox(img, cache, regularPath, nil, "Hello", box, 16, color.Black)
+	if err != nil {
+		t.Fatalf("middle: %v", err)
+	}
+
+	box.VAlign = "bottom"
+	bottom, err := drawTextInBox(img, cache, regularPath, nil, "Hello", box, 16, color.Black)
+	if err != nil {
+		t.Fatalf("bottom: %v", err)
+	}
+
+	if top.Min.Y != box.Y {
+		t.Errorf("top-aligned Min.Y = %d, want box.Y (%d)", top.Min.Y, box.Y)
+	}
+	if middle.Min.Y <= top.Min.Y || middle.Min.Y >= bottom.Min.Y {
+		t.Errorf("middle-aligned Min.Y = %d, want strictly between top (%d) and bottom (%d)", middle.Min.Y, top.Min.Y, bottom.Min.Y)
+	}
+	if bottom.Max.Y != box.Y+box.H {
+		t.Errorf("bottom-aligned Max.Y = %d, want box.Y+box.H (%d)", bottom.Max.Y, box.Y+box.H)
+	}
+}
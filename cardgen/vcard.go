@@ -0,0 +1,67 @@
+package cardgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VCardVersion selects which vCard spec revision BuildVCard emits.
+type VCardVersion string
+
+const (
+	VCard3 VCardVersion = "3.0"
+	VCard4 VCardVersion = "4.0"
+)
+
+// BuildVCard renders cardData as an RFC 6350-compatible vCard string.
+// Pronouns are emitted as the vendor-extension X-PRONOUNS under 3.0, or the
+// standard PRONOUNS property introduced in 4.0.
+func BuildVCard(version VCardVersion, cardData BusinessCard) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCARD\r\n")
+	fmt.Fprintf(&b, "VERSION:%s\r\n", version)
+	fmt.Fprintf(&b, "FN:%s\r\n", vcardEscape(cardData.Name))
+	fmt.Fprintf(&b, "N:%s;;;;\r\n", vcardEscape(cardData.Name))
+
+	if cardData.Title != "" {
+		fmt.Fprintf(&b, "TITLE:%s\r\n", vcardEscape(cardData.Title))
+	}
+	if cardData.Company != "" {
+		org := vcardEscape(cardData.Company)
+		if cardData.Department != "" {
+			org = fmt.Sprintf("%s;%s", org, vcardEscape(cardData.Department))
+		}
+		fmt.Fprintf(&b, "ORG:%s\r\n", org)
+	}
+	if cardData.PhoneNumber != "" {
+		fmt.Fprintf(&b, "TEL;TYPE=work,voice:%s\r\n", vcardEscape(cardData.PhoneNumber))
+	}
+	if cardData.Email != "" {
+		fmt.Fprintf(&b, "EMAIL:%s\r\n", vcardEscape(cardData.Email))
+	}
+	if cardData.Address != "" {
+		fmt.Fprintf(&b, "ADR;TYPE=work:;;%s;;;;\r\n", vcardEscape(cardData.Address))
+	}
+	if cardData.Pronouns != "" {
+		if version == VCard4 {
+			fmt.Fprintf(&b, "PRONOUNS:%s\r\n", vcardEscape(cardData.Pronouns))
+		} else {
+			fmt.Fprintf(&b, "X-PRONOUNS:%s\r\n", vcardEscape(cardData.Pronouns))
+		}
+	}
+
+	b.WriteString("END:VCARD\r\n")
+	return b.String()
+}
+
+// vcardEscape escapes the characters vCard's text value grammar treats as
+// special (RFC 6350 §3.4).
+func vcardEscape(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		",", `\,`,
+		";", `\;`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(s)
+}
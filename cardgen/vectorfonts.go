@@ -0,0 +1,142 @@
+package cardgen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font"
+)
+
+// fontRun is a maximal substring of a box's text whose glyphs are all
+// covered by the same font file, in the same order MultiFace.faceFor picks
+// a face for the raster path: primaryPath first, then fallbackPaths in
+// order, falling back to primaryPath itself if none of them cover a rune.
+type fontRun struct {
+	Path  string
+	Text  string
+	Width int // pixel width at the size vectorTextRuns was called with
+}
+
+// vectorTextRuns splits text into fontRuns and measures each one, so a
+// vector backend (SVG, PDF) that draws text through its own font-selection
+// mechanism rather than MultiFace's rasterizer can still lay a mixed-script
+// line out across multiple embedded fonts instead of just handing the whole
+// string to whichever single font the box names.
+func vectorTextRuns(cache *FontCache, primaryPath string, fallbackPaths []string, text string, size float64) ([]fontRun, error) {
+	paths := append([]string{primaryPath}, fallbackPaths...)
+	faces := make([]font.Face, len(paths))
+	for i, p := range paths {
+		f, err := cache.Face(p, size)
+		if err != nil {
+			return nil, fmt.Errorf("font %s: %w", p, err)
+		}
+		faces[i] = f
+	}
+
+	var runs []fontRun
+	curIdx := -1
+	var curText bytes.Buffer
+
+	flush := func() {
+		if curText.Len() == 0 {
+			return
+		}
+		s := curText.String()
+		width := font.MeasureString(faces[curIdx], s).Ceil()
+		runs = append(runs, fontRun{Path: paths[curIdx], Text: s, Width: width})
+		curText.Reset()
+	}
+
+	for _, r := range text {
+		idx := 0
+		for i, f := range faces {
+			if _, ok := f.GlyphAdvance(r); ok {
+				idx = i
+				break
+			}
+		}
+		if idx != curIdx && curText.Len() > 0 {
+			flush()
+		}
+		curIdx = idx
+		curText.WriteRune(r)
+	}
+	flush()
+
+	return runs, nil
+}
+
+// embedFontFaces returns an SVG <defs><style> block with @font-face rules
+// for regularPath/boldPath/italicPath (all under the "Raleway" family, so
+// the existing font-weight/font-style attributes on <text>/<tspan> select
+// the right one) and one rule per fallbackPath (each its own single-weight
+// family, named "Fallback0", "Fallback1", ...). Embedding the actual font
+// files, rather than just naming "Raleway" and hoping the viewer has it
+// installed, is what lets a mixed-script card render correctly when opened
+// somewhere with no font fallback of its own, e.g. Illustrator. It returns
+// the defs block plus a path-to-family map for the fallback fonts so the
+// caller can pick the right family per fontRun.
+func embedFontFaces(regularPath, boldPath, italicPath string, fallbackPaths []string) (string, map[string]string, error) {
+	var buf bytes.Buffer
+	buf.WriteString("<defs><style>\n")
+
+	writeFace := func(family, weight, style, path string) error {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read font %s: %w", path, err)
+		}
+		encoded := base64.StdEncoding.EncodeToString(raw)
+		fmt.Fprintf(&buf, "@font-face { font-family: %q; font-weight: %s; font-style: %s; src: url(data:font/ttf;base64,%s) format('truetype'); }\n",
+			family, weight, style, encoded)
+		return nil
+	}
+
+	if err := writeFace("Raleway", "normal", "normal", regularPath); err != nil {
+		return "", nil, err
+	}
+	if err := writeFace("Raleway", "bold", "normal", boldPath); err != nil {
+		return "", nil, err
+	}
+	if italicPath != "" {
+		if err := writeFace("Raleway", "normal", "italic", italicPath); err != nil {
+			return "", nil, err
+		}
+	}
+
+	fallbackFamilies := make(map[string]string, len(fallbackPaths))
+	for i, path := range fallbackPaths {
+		if _, ok := fallbackFamilies[path]; ok {
+			continue
+		}
+		family := fmt.Sprintf("Fallback%d", i)
+		if err := writeFace(family, "normal", "normal", path); err != nil {
+			return "", nil, err
+		}
+		fallbackFamilies[path] = family
+	}
+
+	buf.WriteString("</style></defs>\n")
+	return buf.String(), fallbackFamilies, nil
+}
+
+// registerFallbackFonts adds each of fallbackPaths to pdf under its own
+// regular-weight family name ("Fallback0", "Fallback1", ...) and returns a
+// path-to-family map, so a fontRun drawn with a fallback font can select it
+// by path. Fallback fonts are only ever registered at regular weight: a
+// fallback chain covers glyphs the brand font lacks, not bold/italic
+// variants of them.
+func registerFallbackFonts(pdf *gofpdf.Fpdf, fallbackPaths []string) map[string]string {
+	families := make(map[string]string, len(fallbackPaths))
+	for i, path := range fallbackPaths {
+		if _, ok := families[path]; ok {
+			continue
+		}
+		family := fmt.Sprintf("Fallback%d", i)
+		pdf.AddUTF8Font(family, "", path)
+		families[path] = family
+	}
+	return families
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/jalspach/CardCreator/cardgen"
+	"github.com/spf13/cobra"
+)
+
+// newBatchCmd builds the `cardgen batch` subcommand, which reads rows from a
+// CSV file and renders one card per row.
+func newBatchCmd() *cobra.Command {
+	var (
+		csvPath       string
+		bgImagePath   string
+		regularFont   string
+		boldFont      string
+		formatFlag    string
+		templateFlag  string
+		templatesDir  string
+		notoFallbacks string
+		fontCacheDir  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "Render a card for every row of a CSV file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cardgen.ParseFormat(formatFlag)
+			if err != nil {
+				return fmt.Errorf("invalid --format: %w", err)
+			}
+			renderer, err := rendererForBatch(format)
+			if err != nil {
+				return err
+			}
+
+			templates, err := cardgen.LoadTemplateRegistry(templatesDir)
+			if err != nil {
+				return fmt.Errorf("error loading templates: %w", err)
+			}
+			tmpl, ok := templates[templateFlag]
+			if !ok {
+				return fmt.Errorf("unknown template %q (looked in %s)", templateFlag, templatesDir)
+			}
+
+			fallbacks, err := resolveNotoFallbacks(notoFallbacks, fontCacheDir)
+			if err != nil {
+				return err
+			}
+			tmpl.Fonts = append(append([]string{}, tmpl.Fonts...), fallbacks...)
+
+			cards, err := readCardsFromCSV(csvPath)
+			if err != nil {
+				return fmt.Errorf("error reading cards from CSV: %w", err)
+			}
+			if len(cards) == 0 {
+				log.Println("No cards found in the CSV file.")
+				return nil
+			}
+
+			return renderCardsConcurrently(cards, func(card cardgen.BusinessCard) error {
+				return renderAndWriteCard(renderer, tmpl, bgImagePath, regularFont, boldFont, card)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&csvPath, "csv", "./cards.csv", "path to the CSV file of cards to render")
+	cmd.Flags().StringVar(&bgImagePath, "bg", "./BrandingGuidelines_2025.png", "path to the background image")
+	cmd.Flags().StringVar(&regularFont, "regular-font", "./Raleway-Regular.ttf", "path to the regular-weight font")
+	cmd.Flags().StringVar(&boldFont, "bold-font", "./Raleway-Bold.ttf", "path to the bold-weight font")
+	cmd.Flags().StringVar(&formatFlag, "format", "png", "output format: png, svg, or pdf")
+	cmd.Flags().StringVar(&templateFlag, "template", "default", "name of the template to render with")
+	cmd.Flags().StringVar(&templatesDir, "templates-dir", "./templates", "directory of *.yaml templates")
+	addNotoFallbackFlags(cmd, &notoFallbacks, &fontCacheDir)
+
+	return cmd
+}
+
+// rendererForBatch returns the Renderer to use for a batch run, sharing one
+// RenderCache across however many rows the CSV has so the fonts and
+// background are only read and parsed once, no matter how many worker
+// goroutines end up calling Render concurrently.
+func rendererForBatch(format cardgen.Format) (cardgen.Renderer, error) {
+	cache := cardgen.NewRenderCache()
+	switch format {
+	case cardgen.FormatPNG:
+		return cardgen.RasterRenderer{Cache: cache}, nil
+	case cardgen.FormatSVG:
+		return cardgen.SVGRenderer{Cache: cache}, nil
+	case cardgen.FormatPDF:
+		return cardgen.PDFRenderer{Cache: cache}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// renderAndWriteCard renders a single card and writes its output image plus
+// its .vcf sidecar to disk.
+func renderAndWriteCard(renderer cardgen.Renderer, tmpl *cardgen.Template, bgImagePath, regularFont, boldFont string, card cardgen.BusinessCard) error {
+	data, ext, err := renderer.Render(tmpl, bgImagePath, regularFont, boldFont, "", card)
+	if err != nil {
+		return fmt.Errorf("error generating card for %s: %w", card.Name, err)
+	}
+
+	sanitizedName := strings.ReplaceAll(card.Name, " ", "_")
+	outputFileName := fmt.Sprintf("%s_email_signature.%s", sanitizedName, ext)
+	if err := os.WriteFile(outputFileName, data, 0644); err != nil {
+		return fmt.Errorf("failed to write output file %s: %w", outputFileName, err)
+	}
+
+	// Write a .vcf sidecar so the card can be scanned or imported directly,
+	// independent of whether the template also embeds a QR code.
+	vcardFileName := fmt.Sprintf("%s_contact.vcf", sanitizedName)
+	if err := os.WriteFile(vcardFileName, []byte(cardgen.BuildVCard(cardgen.VCard3, card)), 0644); err != nil {
+		return fmt.Errorf("failed to write vCard file %s: %w", vcardFileName, err)
+	}
+
+	fmt.Printf("Successfully generated business card: %s\n", outputFileName)
+	return nil
+}
+
+// renderCardsConcurrently fans cards out across runtime.NumCPU() worker
+// goroutines, each calling render for its share of the rows, and returns the
+// first error encountered (if any) after every card has been attempted.
+func renderCardsConcurrently(cards []cardgen.BusinessCard, render func(cardgen.BusinessCard) error) error {
+	jobs := make(chan cardgen.BusinessCard)
+	errs := make(chan error, len(cards))
+
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for card := range jobs {
+				if err := render(card); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	for _, card := range cards {
+		jobs <- card
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	var firstErr error
+	for err := range errs {
+		log.Println(err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// readCardsFromCSV reads business card data from a CSV file with a header
+// row and columns Name, Pronouns, Title, Company, Address, PhoneNumber,
+// Email.
+func readCardsFromCSV(csvPath string) ([]cardgen.BusinessCard, error) {
+	file, err := os.Open(csvPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	// Read and discard the header row.
+	if _, err := reader.Read(); err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("CSV file is empty")
+		}
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	var cards []cardgen.BusinessCard
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+		}
+
+		if len(record) < 7 {
+			log.Printf("Skipping invalid record: %v (expected 7 fields, got %d)", record, len(record))
+			continue
+		}
+
+		card := cardgen.BusinessCard{
+			Name:        record[0],
+			Pronouns:    record[1],
+			Title:       record[2],
+			Company:     record[3],
+			Address:     record[4],
+			PhoneNumber: record[5],
+			Email:       record[6],
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jalspach/CardCreator/cardgen"
+	"github.com/spf13/cobra"
+)
+
+// addNotoFallbackFlags registers the flags a subcommand needs to opt into
+// downloading Noto fallback fonts on first run.
+func addNotoFallbackFlags(cmd *cobra.Command, notoFallbacks, fontCacheDir *string) {
+	cmd.Flags().StringVar(notoFallbacks, "download-noto-fallbacks", "",
+		`comma-separated Noto fallbacks to download and append as template fallback fonts (e.g. "noto-cjk,noto-emoji")`)
+	cmd.Flags().StringVar(fontCacheDir, "font-cache-dir", defaultFontCacheDir(),
+		"directory downloaded fallback fonts are cached in")
+}
+
+// resolveNotoFallbacks downloads (or reuses a prior download of) the
+// comma-separated Noto fallback names and returns their local font paths,
+// ready to append to a Template's Fonts.
+func resolveNotoFallbacks(names, cacheDir string) ([]string, error) {
+	if names == "" {
+		return nil, nil
+	}
+
+	list := strings.Split(names, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+
+	paths, err := cardgen.EnsureNotoFallbacks(cacheDir, list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download Noto fallbacks: %w", err)
+	}
+	return paths, nil
+}
+
+// defaultFontCacheDir returns a per-user cache directory, falling back to a
+// relative directory if the OS doesn't expose one.
+func defaultFontCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ".cardgen-fonts"
+	}
+	return filepath.Join(dir, "cardgen", "fonts")
+}
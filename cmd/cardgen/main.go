@@ -0,0 +1,28 @@
+// Command cardgen renders CardCreator business cards and email signatures.
+// It replaces the old pair of standalone CSV-batch and HTTP-server binaries
+// with a single tool exposing each as a subcommand.
+package main
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "cardgen",
+		Short: "Generate CardCreator business cards and email signatures",
+	}
+
+	rootCmd.AddCommand(
+		newBatchCmd(),
+		newServeCmd(),
+		newOneCmd(),
+		newPreviewCmd(),
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
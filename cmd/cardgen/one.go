@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jalspach/CardCreator/cardgen"
+	"github.com/spf13/cobra"
+)
+
+// oneRequest is the shape of the JSON file `cardgen one --json` reads: the
+// card data plus everything Render needs to know about, all in one place so
+// a single card can be rendered without a CSV or an HTTP request.
+type oneRequest struct {
+	Card cardgen.BusinessCard `json:"card"`
+
+	Background   string `json:"background"`
+	RegularFont  string `json:"regular_font"`
+	BoldFont     string `json:"bold_font"`
+	ItalicFont   string `json:"italic_font"`
+	Format       string `json:"format"`
+	Template     string `json:"template"`
+	TemplatesDir string `json:"templates_dir"`
+	Output       string `json:"output"`
+}
+
+// newOneCmd builds the `cardgen one` subcommand, which renders a single card
+// described by a JSON file.
+func newOneCmd() *cobra.Command {
+	var jsonPath string
+
+	cmd := &cobra.Command{
+		Use:   "one",
+		Short: "Render a single card described by a JSON file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, err := os.ReadFile(jsonPath)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", jsonPath, err)
+			}
+
+			var req oneRequest
+			if err := json.Unmarshal(raw, &req); err != nil {
+				return fmt.Errorf("failed to parse %s: %w", jsonPath, err)
+			}
+
+			if req.Template == "" {
+				req.Template = "default"
+			}
+			if req.TemplatesDir == "" {
+				req.TemplatesDir = "./templates"
+			}
+
+			format, err := cardgen.ParseFormat(req.Format)
+			if err != nil {
+				return fmt.Errorf("invalid format: %w", err)
+			}
+			renderer, err := cardgen.RendererFor(format)
+			if err != nil {
+				return err
+			}
+
+			templates, err := cardgen.LoadTemplateRegistry(req.TemplatesDir)
+			if err != nil {
+				return fmt.Errorf("error loading templates: %w", err)
+			}
+			tmpl, ok := templates[req.Template]
+			if !ok {
+				return fmt.Errorf("unknown template %q (looked in %s)", req.Template, req.TemplatesDir)
+			}
+
+			data, ext, err := renderer.Render(tmpl, req.Background, req.RegularFont, req.BoldFont, req.ItalicFont, req.Card)
+			if err != nil {
+				return fmt.Errorf("error generating card: %w", err)
+			}
+
+			outputFileName := req.Output
+			if outputFileName == "" {
+				outputFileName = fmt.Sprintf("card.%s", ext)
+			}
+			if err := os.WriteFile(outputFileName, data, 0644); err != nil {
+				return fmt.Errorf("failed to write output file %s: %w", outputFileName, err)
+			}
+
+			fmt.Printf("Successfully generated business card: %s\n", outputFileName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&jsonPath, "json", "./card.json", "path to a JSON file describing the card to render")
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jalspach/CardCreator/cardgen"
+	"github.com/spf13/cobra"
+)
+
+// newPreviewCmd builds the `cardgen preview` subcommand, which loads a
+// template file and, with --watch, reloads and re-validates it every time it
+// changes on disk — useful for catching a typo'd {{.Field}} without a full
+// batch or serve run.
+func newPreviewCmd() *cobra.Command {
+	var watch bool
+
+	cmd := &cobra.Command{
+		Use:   "preview <template.yaml>",
+		Short: "Load (and optionally watch) a template file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+
+			if err := previewOnce(path); err != nil {
+				return err
+			}
+			if !watch {
+				return nil
+			}
+
+			return watchTemplate(path)
+		},
+	}
+
+	cmd.Flags().BoolVar(&watch, "watch", false, "keep watching the template file and re-validate it on every change")
+
+	return cmd
+}
+
+// previewOnce loads path once and prints a summary of its boxes, or the
+// validation error that would otherwise surface at server/batch startup.
+func previewOnce(path string) error {
+	tmpl, err := cardgen.LoadTemplate(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return nil
+	}
+
+	fmt.Printf("template %q: %d box(es)\n", tmpl.Name, len(tmpl.Boxes))
+	for _, box := range tmpl.Boxes {
+		fmt.Printf("  - %s (%s, %gpt) at (%d,%d)\n", box.Name, box.Font, box.Size, box.X, box.Y)
+	}
+	return nil
+}
+
+// watchTemplate polls path's mtime and reruns previewOnce whenever it
+// changes, until the process is interrupted.
+func watchTemplate(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	lastMod := info.ModTime()
+
+	for {
+		time.Sleep(500 * time.Millisecond)
+
+		info, err := os.Stat(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to stat %s: %v\n", path, err)
+			continue
+		}
+		if !info.ModTime().After(lastMod) {
+			continue
+		}
+		lastMod = info.ModTime()
+
+		fmt.Println("--- reloaded ---")
+		if err := previewOnce(path); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/jalspach/CardCreator/cardgen"
+	"github.com/spf13/cobra"
+)
+
+// newServeCmd builds the `cardgen serve` subcommand, which exposes the card
+// renderer over HTTP for the email-signature generator web form.
+func newServeCmd() *cobra.Command {
+	var (
+		addr         string
+		assetsDir    string
+		templatesDir string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the card generator over HTTP",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			templates, err := cardgen.LoadTemplateRegistry(templatesDir)
+			if err != nil {
+				return fmt.Errorf("error loading templates: %w", err)
+			}
+
+			srv := &server{assetsDir: assetsDir, templates: templates, cache: cardgen.NewRenderCache()}
+
+			fs := http.FileServer(http.Dir(assetsDir))
+			http.Handle("/assets/", http.StripPrefix("/assets/", fs))
+			http.HandleFunc("/generate-card", srv.cardHandler)
+			http.HandleFunc("/generate-vcard", srv.vcardHandler)
+			http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				http.ServeFile(w, r, "index.html")
+			})
+
+			fmt.Printf("Server listening on http://localhost%s\n", addr)
+			return http.ListenAndServe(addr, nil)
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", ":8080", "address to listen on")
+	cmd.Flags().StringVar(&assetsDir, "assets-dir", "./assets", "directory of background images and fonts")
+	cmd.Flags().StringVar(&templatesDir, "templates-dir", "./templates", "directory of *.yaml templates")
+
+	return cmd
+}
+
+// server holds the state shared across the HTTP handlers. cache is built
+// once in newServeCmd and shared across every request, so a long-running
+// serve process doesn't re-read and re-parse the same fonts and background
+// image for every card it generates.
+type server struct {
+	assetsDir string
+	templates cardgen.TemplateRegistry
+	cache     *cardgen.RenderCache
+}
+
+// rendererFor returns the Renderer to use for a request, sharing s.cache so
+// repeated requests reuse the same parsed fonts and decoded background.
+func (s *server) rendererFor(format cardgen.Format) (cardgen.Renderer, error) {
+	switch format {
+	case cardgen.FormatPNG:
+		return cardgen.RasterRenderer{Cache: s.cache}, nil
+	case cardgen.FormatSVG:
+		return cardgen.SVGRenderer{Cache: s.cache}, nil
+	case cardgen.FormatPDF:
+		return cardgen.PDFRenderer{Cache: s.cache}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// cardFromForm builds a BusinessCard out of the fields the signature web
+// form posts.
+func cardFromForm(r *http.Request) cardgen.BusinessCard {
+	return cardgen.BusinessCard{
+		Name:        r.FormValue("name"),
+		Pronouns:    r.FormValue("pronouns"),
+		Title:       r.FormValue("title"),
+		Company:     r.FormValue("company"),
+		Department:  r.FormValue("department"),
+		Address:     r.FormValue("address"),
+		PhoneNumber: r.FormValue("phone_number"),
+		Email:       r.FormValue("email"),
+		LandGrant: filterEmpty(
+			r.FormValue("land_grant_1"),
+			r.FormValue("land_grant_2"),
+			r.FormValue("land_grant_3"),
+			r.FormValue("land_grant_4"),
+		),
+	}
+}
+
+// filterEmpty drops empty strings so LandGrant only carries the land
+// acknowledgment lines the submitter actually filled in.
+func filterEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// cardHandler handles the HTTP request to generate a business card.
+func (s *server) cardHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Failed to parse form data", http.StatusBadRequest)
+		return
+	}
+
+	cardData := cardFromForm(r)
+
+	// Set paths to the assets. **You must update these paths.**
+	bgImagePath := s.assetsDir + "/background_image.png"
+	regularFontPath := s.assetsDir + "/Railway-Regular.ttf"
+	boldFontPath := s.assetsDir + "/Railway-Bold.ttf"
+	italicFontPath := s.assetsDir + "/Railway-Italic.ttf"
+
+	format, err := cardgen.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	renderer, err := s.rendererFor(format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	templateName := r.URL.Query().Get("template")
+	if templateName == "" {
+		templateName = "email-signature"
+	}
+	tmpl, ok := s.templates[templateName]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown template %q", templateName), http.StatusBadRequest)
+		return
+	}
+
+	data, ext, err := renderer.Render(tmpl, bgImagePath, regularFontPath, boldFontPath, italicFontPath, cardData)
+	if err != nil {
+		log.Printf("Error generating card: %v", err)
+		http.Error(w, "Failed to generate business card", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"emailsignature.%s\"", ext))
+	if _, err := w.Write(data); err != nil {
+		log.Printf("Error writing response: %v", err)
+	}
+}
+
+// vcardHandler handles the HTTP request to generate a standalone vCard
+// sidecar for a business card, since a single cardHandler response can't
+// carry both the rendered image and the .vcf file.
+func (s *server) vcardHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Failed to parse form data", http.StatusBadRequest)
+		return
+	}
+
+	vcard := cardgen.BuildVCard(cardgen.VCard3, cardFromForm(r))
+
+	w.Header().Set("Content-Type", "text/vcard")
+	w.Header().Set("Content-Disposition", `attachment; filename="contact.vcf"`)
+	if _, err := w.Write([]byte(vcard)); err != nil {
+		log.Printf("Error writing response: %v", err)
+	}
+}